@@ -0,0 +1,158 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// runApply implements `hyprspacefix apply`: the original behavior of the
+// tool, binding a range (or profile) of workspaces to a monitor.
+func runApply(args []string) error {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+	monitorName := fs.String("name", "", "Monitor name")
+	workspaceRange := fs.String("range", "", "Workspace range (e.g., '1-5')")
+	profileName := fs.String("profile", "", "Apply a named profile from the config file instead of --name/--range")
+	configPath := fs.String("config", "", "Path to the config file (default: $XDG_CONFIG_HOME/hyprspacefix/config.yaml)")
+	dryRun := fs.Bool("dry-run", false, "Print commands without executing them")
+	verbose := fs.Bool("verbose", false, "Enable verbose output")
+	watch := fs.Bool("watch", false, "Stay running and re-apply the binding on monitor hotplug/config reload")
+	force := fs.Bool("force", false, "Overwrite workspaces that are already bound to a different monitor")
+
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage:\n")
+		fmt.Fprintf(fs.Output(), "  apply --name=monitor-name --range=start-end [options]\n")
+		fmt.Fprintf(fs.Output(), "  apply --profile=name [options]\n\n")
+		fmt.Fprintf(fs.Output(), "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *verbose {
+		log.SetFlags(log.Ltime | log.Lmicroseconds)
+	}
+
+	var batchCmds []string
+	var reapply func() error
+
+	if *profileName != "" {
+		path := *configPath
+		if path == "" {
+			path = defaultConfigPath()
+		}
+
+		cfg, err := LoadConfig(path)
+		if err != nil {
+			return err
+		}
+
+		bindings, err := cfg.Profile(*profileName)
+		if err != nil {
+			return err
+		}
+
+		q, err := newQuerier(*verbose)
+		if err != nil {
+			return err
+		}
+		if err := validateBindings(q, bindings, *force); err != nil {
+			return err
+		}
+
+		batchCmds = buildProfileBatchCmds(bindings)
+		reapply = func() error {
+			cfg, err := LoadConfig(path)
+			if err != nil {
+				return err
+			}
+			bindings, err := cfg.Profile(*profileName)
+			if err != nil {
+				return err
+			}
+			q, err := newQuerier(*verbose)
+			if err != nil {
+				return err
+			}
+			if err := validateBindings(q, bindings, *force); err != nil {
+				return err
+			}
+			return applyBatch(buildProfileBatchCmds(bindings), *verbose)
+		}
+	} else {
+		if *monitorName == "" || *workspaceRange == "" {
+			fs.Usage()
+			return fmt.Errorf("--name and --range (or --profile) are required")
+		}
+
+		start, end, err := parseRange(*workspaceRange)
+		if err != nil {
+			return err
+		}
+
+		workspaces := make([]int, 0, end-start+1)
+		for ws := start; ws <= end; ws++ {
+			workspaces = append(workspaces, ws)
+		}
+
+		q, err := newQuerier(*verbose)
+		if err != nil {
+			return err
+		}
+		binding := MonitorBinding{Monitor: *monitorName, Workspaces: workspaces}
+		if err := validateBindings(q, []MonitorBinding{binding}, *force); err != nil {
+			return err
+		}
+
+		batchCmds = buildBatchCmds(*monitorName, start, end)
+		reapply = func() error {
+			q, err := newQuerier(*verbose)
+			if err != nil {
+				return err
+			}
+			if err := validateBindings(q, []MonitorBinding{binding}, *force); err != nil {
+				return err
+			}
+			return applyBatch(buildBatchCmds(*monitorName, start, end), *verbose)
+		}
+	}
+
+	if *dryRun {
+		fmt.Println("Would execute:")
+		fmt.Printf("hyprctl --batch '%s'\n", strings.Join(batchCmds, ";"))
+		return nil
+	}
+
+	if err := applyBatch(batchCmds, *verbose); err != nil {
+		return err
+	}
+
+	if *watch {
+		return watchEvents(reapply, *verbose)
+	}
+	return nil
+}
+
+// parseRange parses a "start-end" workspace range, e.g. "1-5".
+func parseRange(s string) (start, end int, err error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("range must be in format 'n-m'")
+	}
+
+	start, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid start range: %w", err)
+	}
+
+	end, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid end range: %w", err)
+	}
+
+	return start, end, nil
+}
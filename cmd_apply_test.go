@@ -0,0 +1,22 @@
+package main
+
+import "testing"
+
+func TestParseRange(t *testing.T) {
+	start, end, err := parseRange("1-5")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if start != 1 || end != 5 {
+		t.Fatalf("got start=%d end=%d, want start=1 end=5", start, end)
+	}
+}
+
+func TestParseRangeErrors(t *testing.T) {
+	cases := []string{"", "1", "1-2-3", "a-5", "1-b"}
+	for _, c := range cases {
+		if _, _, err := parseRange(c); err == nil {
+			t.Errorf("parseRange(%q): expected error, got nil", c)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+)
+
+// runList implements `hyprspacefix list`: print the current monitor ->
+// workspace mapping by querying Hyprland's live state.
+func runList(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	verbose := fs.Bool("verbose", false, "Enable verbose output")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	q, err := newQuerier(*verbose)
+	if err != nil {
+		return err
+	}
+
+	monitors, err := q.Monitors()
+	if err != nil {
+		return fmt.Errorf("querying monitors: %w", err)
+	}
+
+	workspaces, err := q.Workspaces()
+	if err != nil {
+		return fmt.Errorf("querying workspaces: %w", err)
+	}
+
+	byMonitor := make(map[string][]int)
+	for _, ws := range workspaces {
+		byMonitor[ws.Monitor] = append(byMonitor[ws.Monitor], ws.ID)
+	}
+
+	for _, m := range monitors {
+		ids := byMonitor[m.Name]
+		sort.Ints(ids)
+		fmt.Printf("%s: %v\n", m.Name, ids)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// runReset implements `hyprspacefix reset`: remove a monitor's workspace
+// bindings by re-issuing `keyword workspace N,monitor:` with no target,
+// which lets Hyprland fall back to its default placement.
+func runReset(args []string) error {
+	fs := flag.NewFlagSet("reset", flag.ExitOnError)
+	monitorName := fs.String("name", "", "Monitor name to reset")
+	dryRun := fs.Bool("dry-run", false, "Print commands without executing them")
+	verbose := fs.Bool("verbose", false, "Enable verbose output")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *monitorName == "" {
+		fs.Usage()
+		return fmt.Errorf("--name is required")
+	}
+
+	q, err := newQuerier(*verbose)
+	if err != nil {
+		return err
+	}
+
+	monitors, err := q.Monitors()
+	if err != nil {
+		return fmt.Errorf("querying monitors: %w", err)
+	}
+	if err := validateMonitor(monitors, *monitorName); err != nil {
+		return err
+	}
+
+	workspaces, err := q.Workspaces()
+	if err != nil {
+		return fmt.Errorf("querying workspaces: %w", err)
+	}
+
+	var cmds []string
+	for _, ws := range workspaces {
+		if ws.Monitor == *monitorName {
+			cmds = append(cmds, fmt.Sprintf("keyword workspace %d,monitor:", ws.ID))
+		}
+	}
+
+	if len(cmds) == 0 {
+		if *verbose {
+			fmt.Printf("no workspaces bound to %s, nothing to reset\n", *monitorName)
+		}
+		return nil
+	}
+
+	if *dryRun {
+		fmt.Println("Would execute:")
+		fmt.Printf("hyprctl --batch '%s'\n", strings.Join(cmds, ";"))
+		return nil
+	}
+
+	return applyBatch(cmds, *verbose)
+}
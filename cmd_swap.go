@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// runSwap implements `hyprspacefix swap <monitor-a> <monitor-b>`: exchange
+// the workspace assignments currently bound to two monitors, atomically, in
+// one batch.
+func runSwap(args []string) error {
+	fs := flag.NewFlagSet("swap", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Print commands without executing them")
+	verbose := fs.Bool("verbose", false, "Enable verbose output")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: swap <monitor-a> <monitor-b> [options]\n\n")
+		fmt.Fprintf(fs.Output(), "Options:\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rest := fs.Args()
+	if len(rest) != 2 {
+		fs.Usage()
+		return fmt.Errorf("swap requires exactly two monitor names")
+	}
+	a, b := rest[0], rest[1]
+
+	q, err := newQuerier(*verbose)
+	if err != nil {
+		return err
+	}
+
+	monitors, err := q.Monitors()
+	if err != nil {
+		return fmt.Errorf("querying monitors: %w", err)
+	}
+	if err := validateMonitor(monitors, a); err != nil {
+		return err
+	}
+	if err := validateMonitor(monitors, b); err != nil {
+		return err
+	}
+
+	workspaces, err := q.Workspaces()
+	if err != nil {
+		return fmt.Errorf("querying workspaces: %w", err)
+	}
+
+	var cmds []string
+	for _, ws := range workspaces {
+		var target string
+		switch ws.Monitor {
+		case a:
+			target = b
+		case b:
+			target = a
+		default:
+			continue
+		}
+		cmds = append(cmds,
+			fmt.Sprintf("keyword workspace %d,monitor:%s", ws.ID, target),
+			fmt.Sprintf("dispatch moveworkspacetomonitor %d %s", ws.ID, target))
+	}
+
+	if len(cmds) == 0 {
+		if *verbose {
+			fmt.Printf("no workspaces bound to %s or %s, nothing to swap\n", a, b)
+		}
+		return nil
+	}
+
+	if *dryRun {
+		fmt.Println("Would execute:")
+		fmt.Printf("hyprctl --batch '%s'\n", strings.Join(cmds, ";"))
+		return nil
+	}
+
+	return applyBatch(cmds, *verbose)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MonitorBinding describes the workspaces bound to a single monitor within a
+// profile, e.g. `{monitor: DP-1, workspaces: [1,2,3,4,5], default: 1}`.
+type MonitorBinding struct {
+	Monitor          string `yaml:"monitor"`
+	Workspaces       []int  `yaml:"workspaces"`
+	DefaultWorkspace int    `yaml:"default"`
+}
+
+// Config is the top-level shape of $XDG_CONFIG_HOME/hyprspacefix/config.yaml:
+// a set of named profiles, each a list of monitor bindings.
+type Config struct {
+	Profiles map[string][]MonitorBinding `yaml:"profiles"`
+}
+
+// defaultConfigPath returns the conventional location of the config file,
+// honoring $XDG_CONFIG_HOME.
+func defaultConfigPath() string {
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			home = "."
+		}
+		configHome = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configHome, "hyprspacefix", "config.yaml")
+}
+
+// LoadConfig reads and parses the config file at path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Profile looks up a named profile, returning an error listing the known
+// profile names if it isn't found.
+func (c *Config) Profile(name string) ([]MonitorBinding, error) {
+	bindings, ok := c.Profiles[name]
+	if !ok {
+		var names []string
+		for n := range c.Profiles {
+			names = append(names, n)
+		}
+		return nil, fmt.Errorf("profile %q not found (available: %s)", name, joinOrNone(names))
+	}
+	return bindings, nil
+}
+
+func joinOrNone(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	out := names[0]
+	for _, n := range names[1:] {
+		out += ", " + n
+	}
+	return out
+}
+
+// buildProfileBatchCmds builds the keyword/dispatch commands for every
+// monitor in a profile as one flat batch. Each monitor's commands are
+// followed directly by a workspace-focus dispatch for that monitor's default
+// workspace (if set), before the next monitor's commands.
+func buildProfileBatchCmds(bindings []MonitorBinding) []string {
+	var cmds []string
+	for _, b := range bindings {
+		cmds = append(cmds, buildBatchCmdsForWorkspaces(b.Monitor, b.Workspaces)...)
+		if b.DefaultWorkspace != 0 {
+			cmds = append(cmds, fmt.Sprintf("dispatch workspace %d", b.DefaultWorkspace))
+		}
+	}
+	return cmds
+}
+
+// buildBatchCmdsForWorkspaces is like buildBatchCmds but takes an explicit,
+// possibly non-contiguous, list of workspace numbers.
+func buildBatchCmdsForWorkspaces(monitorName string, workspaces []int) []string {
+	var cmds []string
+	for _, ws := range workspaces {
+		cmds = append(cmds,
+			fmt.Sprintf("keyword workspace %d,monitor:%s", ws, monitorName),
+			fmt.Sprintf("dispatch moveworkspacetomonitor %d %s", ws, monitorName))
+	}
+	return cmds
+}
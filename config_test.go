@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testConfigYAML = `
+profiles:
+  home:
+    - monitor: DP-1
+      workspaces: [1, 2, 3, 4, 5]
+      default: 1
+    - monitor: HDMI-A-1
+      workspaces: [6, 7, 8]
+      default: 6
+`
+
+func writeTestConfig(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(testConfigYAML), 0o644); err != nil {
+		t.Fatalf("writing test config: %v", err)
+	}
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := LoadConfig(writeTestConfig(t))
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if len(cfg.Profiles) != 1 {
+		t.Fatalf("expected 1 profile, got %d", len(cfg.Profiles))
+	}
+}
+
+func TestConfigProfile(t *testing.T) {
+	cfg, err := LoadConfig(writeTestConfig(t))
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+
+	bindings, err := cfg.Profile("home")
+	if err != nil {
+		t.Fatalf("Profile(home): %v", err)
+	}
+	if len(bindings) != 2 {
+		t.Fatalf("expected 2 bindings, got %d", len(bindings))
+	}
+	if bindings[0].Monitor != "DP-1" || bindings[0].DefaultWorkspace != 1 {
+		t.Errorf("unexpected first binding: %+v", bindings[0])
+	}
+
+	if _, err := cfg.Profile("missing"); err == nil {
+		t.Error("expected error for missing profile, got nil")
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// eventSocketPath returns the path to Hyprland's event socket for the
+// current session.
+func eventSocketPath() (string, error) {
+	sig := os.Getenv("HYPRLAND_INSTANCE_SIGNATURE")
+	if sig == "" {
+		return "", fmt.Errorf("HYPRLAND_INSTANCE_SIGNATURE not set (not running under Hyprland?)")
+	}
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		runtimeDir = "/tmp"
+	}
+	return filepath.Join(runtimeDir, "hypr", sig, ".socket2.sock"), nil
+}
+
+// reapplyTriggers are the Hyprland event names that warrant re-applying the
+// current workspace-to-monitor binding.
+var reapplyTriggers = map[string]bool{
+	"monitoradded":   true,
+	"monitoraddedv2": true,
+	"monitorremoved": true,
+	"configreloaded": true,
+}
+
+// watchEvents connects to Hyprland's event socket and calls reapply every
+// time a monitor hotplug or config reload event is seen. It reconnects with
+// exponential backoff if the socket drops, but returns immediately if the
+// event socket path can't be determined at all (e.g.
+// $HYPRLAND_INSTANCE_SIGNATURE isn't set) since that isn't a transient
+// condition a reconnect would fix.
+func watchEvents(reapply func() error, verbose bool) error {
+	path, err := eventSocketPath()
+	if err != nil {
+		return err
+	}
+
+	backoff := time.Second
+	const maxBackoff = 30 * time.Second
+
+	for {
+		connected, err := watchEventsOnce(path, reapply, verbose)
+		if err != nil {
+			log.Printf("event socket error: %v (retrying in %s)", err, backoff)
+		}
+		if connected {
+			// We held a working connection, however briefly; the next
+			// reconnect shouldn't pay for earlier, unrelated drops.
+			backoff = time.Second
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// watchEventsOnce connects once and processes events until the connection
+// drops. The returned bool reports whether a connection was actually
+// established, regardless of how the connection later ended.
+func watchEventsOnce(path string, reapply func() error, verbose bool) (bool, error) {
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		return false, fmt.Errorf("dial event socket: %w", err)
+	}
+	defer conn.Close()
+
+	if verbose {
+		log.Printf("Connected to Hyprland event socket at %s", path)
+	}
+
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		line := scanner.Text()
+		name, _, ok := strings.Cut(line, ">>")
+		if !ok {
+			continue
+		}
+
+		if verbose {
+			log.Printf("event: %s", line)
+		}
+
+		if !reapplyTriggers[name] {
+			continue
+		}
+
+		if verbose {
+			log.Printf("re-applying workspace binding after %q", name)
+		}
+		if err := reapply(); err != nil {
+			log.Printf("failed to re-apply binding: %v", err)
+		}
+	}
+	return true, scanner.Err()
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+
+	"github.com/simonm/hyprspacefix/internal/hypripc"
+)
+
+// batcher runs a set of hyprctl-syntax commands as a single atomic batch.
+type batcher interface {
+	RunBatch(cmds []string) (string, error)
+}
+
+// ipcBatcher sends batches over Hyprland's native IPC socket.
+type ipcBatcher struct {
+	client *hypripc.RequestClient
+}
+
+func (b *ipcBatcher) RunBatch(cmds []string) (string, error) {
+	return b.client.Batch(cmds...)
+}
+
+// subprocessBatcher shells out to `hyprctl --batch`, used when the IPC
+// socket can't be reached.
+type subprocessBatcher struct{}
+
+func (b *subprocessBatcher) RunBatch(cmds []string) (string, error) {
+	batchCmd := strings.Join(cmds, ";")
+	cmd := exec.Command("hyprctl", "--batch", batchCmd)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(output), fmt.Errorf("executing batch commands: %w\nOutput: %s", err, output)
+	}
+	return string(output), nil
+}
+
+// newBatcher picks the fastest available way to talk to Hyprland: the native
+// IPC socket first, falling back to shelling out to hyprctl when the socket
+// is unavailable.
+func newBatcher(verbose bool) (batcher, error) {
+	client, err := hypripc.NewRequestClient()
+	if err == nil {
+		if verbose {
+			log.Println("Using native Hyprland IPC socket")
+		}
+		return &ipcBatcher{client: client}, nil
+	}
+
+	if verbose {
+		log.Printf("Native Hyprland IPC unavailable (%v), falling back to hyprctl", err)
+	}
+	if lookErr := checkHyprctl(); lookErr != nil {
+		return nil, fmt.Errorf("neither the Hyprland IPC socket nor hyprctl are available: %v", lookErr)
+	}
+	return &subprocessBatcher{}, nil
+}
+
+// querier reads Hyprland's current monitor and workspace state.
+type querier interface {
+	Monitors() ([]hypripc.Monitor, error)
+	Workspaces() ([]hypripc.Workspace, error)
+}
+
+// ipcQuerier queries Hyprland's native IPC socket.
+type ipcQuerier struct {
+	client *hypripc.RequestClient
+}
+
+func (q *ipcQuerier) Monitors() ([]hypripc.Monitor, error)     { return q.client.Monitors() }
+func (q *ipcQuerier) Workspaces() ([]hypripc.Workspace, error) { return q.client.Workspaces() }
+
+// subprocessQuerier shells out to `hyprctl <subcommand> -j`, used when the
+// IPC socket can't be reached.
+type subprocessQuerier struct{}
+
+func (q *subprocessQuerier) Monitors() ([]hypripc.Monitor, error) {
+	var monitors []hypripc.Monitor
+	if err := runHyprctlJSON("monitors", &monitors); err != nil {
+		return nil, err
+	}
+	return monitors, nil
+}
+
+func (q *subprocessQuerier) Workspaces() ([]hypripc.Workspace, error) {
+	var workspaces []hypripc.Workspace
+	if err := runHyprctlJSON("workspaces", &workspaces); err != nil {
+		return nil, err
+	}
+	return workspaces, nil
+}
+
+// runHyprctlJSON runs `hyprctl <subcommand> -j` and decodes its output into v.
+func runHyprctlJSON(subcommand string, v interface{}) error {
+	output, err := exec.Command("hyprctl", subcommand, "-j").Output()
+	if err != nil {
+		return fmt.Errorf("running hyprctl %s -j: %w", subcommand, err)
+	}
+	if err := json.Unmarshal(output, v); err != nil {
+		return fmt.Errorf("decoding hyprctl %s -j output: %w", subcommand, err)
+	}
+	return nil
+}
+
+// newQuerier picks the fastest available way to read Hyprland's state: the
+// native IPC socket first, falling back to shelling out to hyprctl when the
+// socket is unavailable.
+func newQuerier(verbose bool) (querier, error) {
+	client, err := hypripc.NewRequestClient()
+	if err == nil {
+		if verbose {
+			log.Println("Using native Hyprland IPC socket")
+		}
+		return &ipcQuerier{client: client}, nil
+	}
+
+	if verbose {
+		log.Printf("Native Hyprland IPC unavailable (%v), falling back to hyprctl", err)
+	}
+	if lookErr := checkHyprctl(); lookErr != nil {
+		return nil, fmt.Errorf("neither the Hyprland IPC socket nor hyprctl are available: %v", lookErr)
+	}
+	return &subprocessQuerier{}, nil
+}
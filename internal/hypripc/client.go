@@ -0,0 +1,126 @@
+// Package hypripc implements a minimal client for Hyprland's UNIX socket IPC
+// protocol. It lets callers talk to a running Hyprland compositor directly,
+// without the fork/exec overhead of shelling out to hyprctl.
+package hypripc
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// RequestClient talks to Hyprland's request socket (.socket.jsonl) to run
+// hyprctl-equivalent commands without spawning a subprocess.
+type RequestClient struct {
+	sockPath string
+}
+
+// socketPath returns the path to Hyprland's request socket for the current
+// session, derived from $XDG_RUNTIME_DIR and $HYPRLAND_INSTANCE_SIGNATURE.
+func socketPath() (string, error) {
+	sig := os.Getenv("HYPRLAND_INSTANCE_SIGNATURE")
+	if sig == "" {
+		return "", fmt.Errorf("HYPRLAND_INSTANCE_SIGNATURE not set (not running under Hyprland?)")
+	}
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		runtimeDir = "/tmp"
+	}
+	return filepath.Join(runtimeDir, "hypr", sig, ".socket.jsonl"), nil
+}
+
+// NewRequestClient locates the current Hyprland session's request socket and
+// returns a client for it. It does not dial the socket until a command is
+// sent, so a stale/unreachable socket only surfaces an error on first use.
+func NewRequestClient() (*RequestClient, error) {
+	path, err := socketPath()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("hyprland request socket not found at %s: %w", path, err)
+	}
+	return &RequestClient{sockPath: path}, nil
+}
+
+// raw sends a single, already-formatted command to the request socket and
+// returns the raw response bytes.
+func (c *RequestClient) raw(cmd string) ([]byte, error) {
+	conn, err := net.Dial("unix", c.sockPath)
+	if err != nil {
+		return nil, fmt.Errorf("dial hyprland socket: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte(cmd)); err != nil {
+		return nil, fmt.Errorf("write to hyprland socket: %w", err)
+	}
+
+	var out strings.Builder
+	reader := bufio.NewReader(conn)
+	buf := make([]byte, 4096)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			out.Write(buf[:n])
+		}
+		if err != nil {
+			break
+		}
+	}
+	return []byte(out.String()), nil
+}
+
+// Dispatch runs a single `hyprctl dispatch` command, e.g.
+// Dispatch("moveworkspacetomonitor", "3", "DP-1").
+func (c *RequestClient) Dispatch(args ...string) (string, error) {
+	out, err := c.raw("dispatch " + strings.Join(args, " "))
+	return string(out), err
+}
+
+// Keyword sets a single Hyprland config keyword at runtime, equivalent to
+// `hyprctl keyword <name> <value>`.
+func (c *RequestClient) Keyword(name, value string) (string, error) {
+	out, err := c.raw(fmt.Sprintf("keyword %s %s", name, value))
+	return string(out), err
+}
+
+// Batch runs multiple commands atomically in a single round trip, equivalent
+// to `hyprctl --batch 'cmd1;cmd2;...'`. Commands are given in hyprctl's plain
+// syntax, e.g. "keyword workspace 3,monitor:DP-1".
+func (c *RequestClient) Batch(cmds ...string) (string, error) {
+	out, err := c.raw("[[BATCH]]" + strings.Join(cmds, ";"))
+	return string(out), err
+}
+
+// Monitors returns the list of connected monitors, equivalent to
+// `hyprctl monitors -j`.
+func (c *RequestClient) Monitors() ([]Monitor, error) {
+	out, err := c.raw("j/monitors")
+	if err != nil {
+		return nil, err
+	}
+	var monitors []Monitor
+	if err := json.Unmarshal(out, &monitors); err != nil {
+		return nil, fmt.Errorf("decode monitors response: %w", err)
+	}
+	return monitors, nil
+}
+
+// Workspaces returns the list of existing workspaces, equivalent to
+// `hyprctl workspaces -j`.
+func (c *RequestClient) Workspaces() ([]Workspace, error) {
+	out, err := c.raw("j/workspaces")
+	if err != nil {
+		return nil, err
+	}
+	var workspaces []Workspace
+	if err := json.Unmarshal(out, &workspaces); err != nil {
+		return nil, fmt.Errorf("decode workspaces response: %w", err)
+	}
+	return workspaces, nil
+}
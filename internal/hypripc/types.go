@@ -0,0 +1,26 @@
+package hypripc
+
+// Monitor mirrors the JSON object returned by `hyprctl monitors -j`.
+type Monitor struct {
+	ID              int     `json:"id"`
+	Name            string  `json:"name"`
+	Description     string  `json:"description"`
+	Width           int     `json:"width"`
+	Height          int     `json:"height"`
+	RefreshRate     float64 `json:"refreshRate"`
+	X               int     `json:"x"`
+	Y               int     `json:"y"`
+	ActiveWorkspace struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"activeWorkspace"`
+	Focused bool `json:"focused"`
+}
+
+// Workspace mirrors the JSON object returned by `hyprctl workspaces -j`.
+type Workspace struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Monitor string `json:"monitor"`
+	Windows int    `json:"windows"`
+}
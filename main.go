@@ -1,13 +1,10 @@
 package main
 
 import (
-	"flag"
 	"fmt"
 	"log"
 	"os"
 	"os/exec"
-	"strconv"
-	"strings"
 )
 
 // checkHyprctl verifies that hyprctl is available
@@ -19,94 +16,67 @@ func checkHyprctl() error {
 	return nil
 }
 
-func main() {
-	// Define command line flags
-	monitorName := flag.String("name", "", "Monitor name")
-	workspaceRange := flag.String("range", "", "Workspace range (e.g., '1-5')")
-	dryRun := flag.Bool("dry-run", false, "Print commands without executing them")
-	verbose := flag.Bool("verbose", false, "Enable verbose output")
-
-	// Custom usage message
-	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
-		fmt.Fprintf(
-			os.Stderr,
-			"  %s --name=monitor-name --range=start-end [options]\n\n",
-			os.Args[0],
-		)
-		fmt.Fprintf(os.Stderr, "Example:\n")
-		fmt.Fprintf(os.Stderr, "  %s --name=DP-1 --range=1-5\n\n", os.Args[0])
-		fmt.Fprintf(os.Stderr, "Options:\n")
-		flag.PrintDefaults()
-	}
-
-	flag.Parse()
+// commands maps each subcommand name to its handler. Each handler parses
+// its own flags from args (os.Args[2:]) and returns an error.
+var commands = map[string]func(args []string) error{
+	"apply": runApply,
+	"list":  runList,
+	"reset": runReset,
+	"swap":  runSwap,
+}
 
-	// Setup logging
+func main() {
 	log.SetFlags(0) // Clean log output
-	if *verbose {
-		log.SetFlags(log.Ltime | log.Lmicroseconds)
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
 	}
 
-	// Validate input
-	if *monitorName == "" || *workspaceRange == "" {
-		flag.Usage()
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", os.Args[1])
+		usage()
 		os.Exit(1)
 	}
 
-	// Check for hyprctl
-	if err := checkHyprctl(); err != nil {
+	if err := cmd(os.Args[2:]); err != nil {
 		log.Fatal(err)
 	}
+}
 
-	// Parse range
-	rangeParts := strings.Split(*workspaceRange, "-")
-	if len(rangeParts) != 2 {
-		log.Fatal("Range must be in format 'n-m'")
-	}
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [options]\n\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Commands:\n")
+	fmt.Fprintf(os.Stderr, "  apply   bind workspaces to a monitor (--name/--range or --profile)\n")
+	fmt.Fprintf(os.Stderr, "  list    print the current monitor -> workspace mapping\n")
+	fmt.Fprintf(os.Stderr, "  reset   remove a monitor's workspace bindings\n")
+	fmt.Fprintf(os.Stderr, "  swap    exchange workspace assignments between two monitors\n")
+	fmt.Fprintf(os.Stderr, "\nRun '%s <command> -h' for command-specific options.\n", os.Args[0])
+}
 
-	start, err := strconv.Atoi(rangeParts[0])
+// applyBatch picks the fastest available way to talk to Hyprland and runs
+// cmds as a single atomic batch.
+func applyBatch(cmds []string, verbose bool) error {
+	b, err := newBatcher(verbose)
 	if err != nil {
-		log.Fatal("Invalid start range:", err)
+		return err
 	}
-
-	end, err := strconv.Atoi(rangeParts[1])
-	if err != nil {
-		log.Fatal("Invalid end range:", err)
+	if _, err := b.RunBatch(cmds); err != nil {
+		return fmt.Errorf("executing batch commands: %w", err)
 	}
-
-	// Build batch commands
-	var batchCmds []string
-	for ws := start; ws <= end; ws++ {
-		batchCmds = append(batchCmds,
-			fmt.Sprintf("keyword workspace %d,monitor:%s", ws, *monitorName),
-			fmt.Sprintf("dispatch moveworkspacetomonitor %d %s", ws, *monitorName))
-	}
-
-	// Join all commands with semicolons
-	batchCmd := strings.Join(batchCmds, ";")
-
-	if *dryRun {
-		fmt.Println("Would execute:")
-		fmt.Printf("hyprctl --batch '%s'\n", batchCmd)
-		return
-	}
-
-	if *verbose {
-		log.Printf("Executing batch command for workspaces %d-%d on monitor %s",
-			start, end, *monitorName)
-	}
-
-	// Execute all commands in a single batch
-	cmd := exec.Command("hyprctl", "--batch", batchCmd)
-
-	// Capture and display any error output
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		log.Fatalf("Error executing batch commands: %v\nOutput: %s", err, output)
+	if verbose {
+		log.Printf("Successfully applied %d commands", len(cmds))
 	}
+	return nil
+}
 
-	if *verbose {
-		log.Printf("Successfully configured %d workspaces", end-start+1)
+// buildBatchCmds builds the keyword/dispatch command pairs that bind each
+// workspace in [start, end] to monitorName.
+func buildBatchCmds(monitorName string, start, end int) []string {
+	workspaces := make([]int, 0, end-start+1)
+	for ws := start; ws <= end; ws++ {
+		workspaces = append(workspaces, ws)
 	}
+	return buildBatchCmdsForWorkspaces(monitorName, workspaces)
 }
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/simonm/hyprspacefix/internal/hypripc"
+)
+
+// validateMonitor checks that name matches a connected monitor's name or
+// description, returning a clear error listing what is available if not.
+func validateMonitor(monitors []hypripc.Monitor, name string) error {
+	for _, m := range monitors {
+		if m.Name == name || m.Description == name {
+			return nil
+		}
+	}
+	var names []string
+	for _, m := range monitors {
+		names = append(names, m.Name)
+	}
+	return fmt.Errorf("monitor %q not connected (available: %s)", name, joinOrNone(names))
+}
+
+// validateBindings checks every monitor binding against live Hyprland state:
+// each monitor must be connected, and (unless force is set) none of its
+// workspaces may already be bound to a different monitor.
+func validateBindings(q querier, bindings []MonitorBinding, force bool) error {
+	monitors, err := q.Monitors()
+	if err != nil {
+		return fmt.Errorf("querying monitors: %w", err)
+	}
+	workspaces, err := q.Workspaces()
+	if err != nil {
+		return fmt.Errorf("querying workspaces: %w", err)
+	}
+
+	for _, b := range bindings {
+		if err := validateMonitor(monitors, b.Monitor); err != nil {
+			return err
+		}
+		if force {
+			continue
+		}
+		if msgs := collisions(workspaces, b.Monitor, b.Workspaces); len(msgs) > 0 {
+			return fmt.Errorf("%s (use --force to overwrite)", strings.Join(msgs, "; "))
+		}
+	}
+	return nil
+}
+
+// collisions reports workspaces in want that are already bound to a monitor
+// other than monitorName, as human-readable messages.
+func collisions(workspaces []hypripc.Workspace, monitorName string, want []int) []string {
+	wanted := make(map[int]bool, len(want))
+	for _, ws := range want {
+		wanted[ws] = true
+	}
+
+	var msgs []string
+	for _, ws := range workspaces {
+		if wanted[ws.ID] && ws.Monitor != "" && ws.Monitor != monitorName {
+			msgs = append(msgs, fmt.Sprintf("workspace %d is already bound to %s", ws.ID, ws.Monitor))
+		}
+	}
+	return msgs
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/simonm/hyprspacefix/internal/hypripc"
+)
+
+func TestValidateMonitor(t *testing.T) {
+	monitors := []hypripc.Monitor{
+		{Name: "DP-1", Description: "Dell U2720Q"},
+		{Name: "HDMI-A-1", Description: "LG TV"},
+	}
+
+	if err := validateMonitor(monitors, "DP-1"); err != nil {
+		t.Errorf("expected DP-1 to validate by name, got: %v", err)
+	}
+	if err := validateMonitor(monitors, "LG TV"); err != nil {
+		t.Errorf("expected LG TV to validate by description, got: %v", err)
+	}
+	if err := validateMonitor(monitors, "DP-3"); err == nil {
+		t.Error("expected error for unconnected monitor DP-3, got nil")
+	}
+}
+
+func TestCollisions(t *testing.T) {
+	workspaces := []hypripc.Workspace{
+		{ID: 1, Monitor: "DP-1"},
+		{ID: 2, Monitor: "HDMI-A-1"},
+		{ID: 3, Monitor: ""},
+	}
+
+	msgs := collisions(workspaces, "DP-1", []int{1, 2, 3})
+	if len(msgs) != 1 {
+		t.Fatalf("expected 1 collision, got %d: %v", len(msgs), msgs)
+	}
+
+	if msgs := collisions(workspaces, "DP-1", []int{1}); len(msgs) != 0 {
+		t.Errorf("expected no collision for a workspace already on the target monitor, got: %v", msgs)
+	}
+
+	if msgs := collisions(workspaces, "DP-1", []int{4}); len(msgs) != 0 {
+		t.Errorf("expected no collision for an unbound workspace, got: %v", msgs)
+	}
+}